@@ -0,0 +1,113 @@
+package cassandra
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Entry is a single value and its expiration, used by SetMulti.
+type Entry struct {
+	Value      []byte
+	Expiration time.Duration
+}
+
+// SetMulti stores multiple key-value pairs in one or more Cassandra
+// batches, using the same TTL handling as Set. Batches are chunked to
+// s.batchSize statements to stay under the cluster's
+// batch_size_warn_threshold; pass WithBatchType(gocql.UnloggedBatch) when
+// every key shares a partition key for higher throughput.
+func (s *Storage) SetMulti(entries map[string]Entry, opts ...Option) error {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+
+	for _, chunk := range chunkStrings(keys, s.batchSize) {
+		batch := s.session.NewBatch(gocql.LoggedBatch)
+		for _, key := range chunk {
+			entry := entries[key]
+			query, args := s.setQuery(key, entry.Value, entry.Expiration, false)
+			addBatchEntry(batch, opts, query, args...)
+		}
+		if err := s.session.ExecuteBatch(applyBatchOptions(batch, opts)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMulti retrieves the values for keys in a single query, skipping and
+// backup-deleting any rows that have expired but not yet been reaped by
+// TTL, mirroring the expiry check in Get.
+func (s *Storage) GetMulti(keys []string, opts ...Option) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(keys))
+	if len(keys) == 0 {
+		return result, nil
+	}
+
+	query := fmt.Sprintf("SELECT key, value, expires_at FROM %s.%s WHERE key IN ?", s.keyspace, s.table)
+	iter := applyOptions(s.session.Query(query, keys), opts).Iter()
+
+	var expired []string
+	var key string
+	var value []byte
+	var expiresAt time.Time
+	for iter.Scan(&key, &value, &expiresAt) {
+		if !expiresAt.IsZero() && expiresAt.Before(time.Now()) {
+			expired = append(expired, key)
+			continue
+		}
+		result[key] = value
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	if len(expired) > 0 {
+		if err := s.DeleteMulti(expired); err != nil {
+			log.Printf("Failed to delete expired keys %v: %v", expired, err)
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteMulti removes keys from storage using one or more batches,
+// chunked the same way as SetMulti.
+func (s *Storage) DeleteMulti(keys []string, opts ...Option) error {
+	query := fmt.Sprintf("DELETE FROM %s.%s WHERE key = ?", s.keyspace, s.table)
+
+	for _, chunk := range chunkStrings(keys, s.batchSize) {
+		batch := s.session.NewBatch(gocql.LoggedBatch)
+		for _, key := range chunk {
+			addBatchEntry(batch, opts, query, key)
+		}
+		if err := s.session.ExecuteBatch(applyBatchOptions(batch, opts)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chunkStrings splits keys into slices of at most size elements.
+func chunkStrings(keys []string, size int) [][]string {
+	if size <= 0 {
+		size = len(keys)
+	}
+
+	var chunks [][]string
+	for i := 0; i < len(keys); i += size {
+		end := i + size
+		if end > len(keys) {
+			end = len(keys)
+		}
+		chunks = append(chunks, keys[i:end])
+	}
+
+	return chunks
+}
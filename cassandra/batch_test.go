@@ -0,0 +1,36 @@
+package cassandra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Cassandra_SetMultiGetMultiDeleteMulti(t *testing.T) {
+	entries := map[string]Entry{
+		"multi-1": {Value: []byte("one")},
+		"multi-2": {Value: []byte("two")},
+	}
+
+	err := testStore.SetMulti(entries)
+	require.NoError(t, err)
+
+	got, err := testStore.GetMulti([]string{"multi-1", "multi-2", "multi-missing"})
+	require.NoError(t, err)
+	require.Equal(t, []byte("one"), got["multi-1"])
+	require.Equal(t, []byte("two"), got["multi-2"])
+	require.NotContains(t, got, "multi-missing")
+
+	err = testStore.DeleteMulti([]string{"multi-1", "multi-2"})
+	require.NoError(t, err)
+
+	got, err = testStore.GetMulti([]string{"multi-1", "multi-2"})
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func Test_Cassandra_GetMulti_Empty(t *testing.T) {
+	got, err := testStore.GetMulti(nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
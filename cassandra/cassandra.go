@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -17,6 +19,14 @@ type Storage struct {
 	keyspace string
 	table    string
 	ttl      int
+
+	replicationStrategy string
+	replicationFactor   int
+	dataCenters         map[string]int
+	durableWrites       *bool
+	batchSize           int
+	udt                 *udtOption
+	extraUpdates        map[int]UpdateFn
 }
 
 var (
@@ -32,8 +42,9 @@ func validateIdentifier(name, field string) (string, error) {
 	return name, nil
 }
 
-// New creates a new Cassandra storage instance
-func New(cnfg Config) (*Storage, error) {
+// New creates a new Cassandra storage instance. opts configures optional
+// behavior that doesn't fit in Config, such as WithUDT.
+func New(cnfg Config, opts ...NewOption) (*Storage, error) {
 	// Default config
 	cfg := configDefault(cnfg)
 
@@ -62,10 +73,19 @@ func New(cnfg Config) (*Storage, error) {
 
 	// Create storage instance
 	storage := &Storage{
-		cluster:  cluster,
-		keyspace: keyspace,
-		table:    table,
-		ttl:      ttl,
+		cluster:             cluster,
+		keyspace:            keyspace,
+		table:               table,
+		ttl:                 ttl,
+		replicationStrategy: cfg.ReplicationStrategy,
+		replicationFactor:   cfg.ReplicationFactor,
+		dataCenters:         cfg.DataCenters,
+		durableWrites:       cfg.DurableWrites,
+		batchSize:           cfg.BatchSize,
+	}
+
+	for _, opt := range opts {
+		opt(storage)
 	}
 
 	// Initialize keyspace
@@ -103,18 +123,25 @@ func (s *Storage) createOrVerifyKeySpace(reset bool) error {
 	}
 	s.session = session
 
-	// Drop tables if reset is requested
+	// Drop tables if reset is requested, so migrations start from scratch
 	if reset {
 		if err := s.dropTables(); err != nil {
 			return err
 		}
 	}
 
-	// Create data table if necessary
-	if err := s.createDataTable(); err != nil {
+	// Bring the keyspace up to the latest registered schema update
+	if err := s.runMigrations(); err != nil {
 		return err
 	}
 
+	// Set up the optional UDT payload column, if configured
+	if s.udt != nil {
+		if err := s.ensureUDT(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -131,10 +158,15 @@ func (s *Storage) ensureKeyspace(systemSession *gocql.Session) error {
 
 	// Create keyspace if it doesn't exist
 	if count == 0 {
-		query := fmt.Sprintf(
-			"CREATE KEYSPACE %s WITH REPLICATION = {'class': 'SimpleStrategy', 'replication_factor': 1}",
-			s.keyspace,
-		)
+		replication, err := s.replicationClause()
+		if err != nil {
+			return err
+		}
+
+		query := fmt.Sprintf("CREATE KEYSPACE %s WITH REPLICATION = %s", s.keyspace, replication)
+		if s.durableWrites != nil {
+			query += fmt.Sprintf(" AND DURABLE_WRITES = %t", *s.durableWrites)
+		}
 		if err := systemSession.Query(query).Exec(); err != nil {
 			return err
 		}
@@ -144,17 +176,32 @@ func (s *Storage) ensureKeyspace(systemSession *gocql.Session) error {
 	return nil
 }
 
-// createDataTable creates the data table for key-value storage
-func (s *Storage) createDataTable() error {
-	query := fmt.Sprintf(`
-		CREATE TABLE IF NOT EXISTS %s.%s (
-			key text PRIMARY KEY,
-			value blob,
-			expires_at timestamp
-		)
-	`, s.keyspace, s.table)
-
-	return s.session.Query(query).Exec()
+// replicationClause builds the `WITH REPLICATION = {...}` map for
+// s.replicationStrategy, validating the strategy-specific options.
+func (s *Storage) replicationClause() (string, error) {
+	switch s.replicationStrategy {
+	case "", "SimpleStrategy":
+		if s.replicationFactor <= 0 {
+			return "", fmt.Errorf("cassandra: ReplicationFactor must be > 0 for SimpleStrategy")
+		}
+		return fmt.Sprintf("{'class': 'SimpleStrategy', 'replication_factor': %d}", s.replicationFactor), nil
+	case "NetworkTopologyStrategy":
+		if len(s.dataCenters) == 0 {
+			return "", fmt.Errorf("cassandra: DataCenters must be non-empty for NetworkTopologyStrategy")
+		}
+		dcs := make([]string, 0, len(s.dataCenters))
+		for dc, rf := range s.dataCenters {
+			dc, err := validateIdentifier(dc, "data center")
+			if err != nil {
+				return "", err
+			}
+			dcs = append(dcs, fmt.Sprintf("'%s': %d", dc, rf))
+		}
+		sort.Strings(dcs)
+		return fmt.Sprintf("{'class': 'NetworkTopologyStrategy', %s}", strings.Join(dcs, ", ")), nil
+	default:
+		return "", fmt.Errorf("cassandra: unsupported ReplicationStrategy %q", s.replicationStrategy)
+	}
 }
 
 // dropTables drops existing tables for reset
@@ -172,7 +219,20 @@ func (s *Storage) dropTables() error {
 
 // Set stores a key-value pair with optional expiration
 func (s *Storage) Set(key string, value []byte, exp time.Duration) error {
-	// Calculate expiration time
+	return s.SetWithOptions(key, value, exp)
+}
+
+// SetWithOptions stores a key-value pair with optional expiration, applying
+// per-call options such as WithConsistency or WithIdempotent.
+func (s *Storage) SetWithOptions(key string, value []byte, exp time.Duration, opts ...Option) error {
+	query, args := s.setQuery(key, value, exp, false)
+	return applyOptions(s.session.Query(query, args...), opts).Exec()
+}
+
+// ttlAndExpiry resolves exp (falling back to the default TTL from Config
+// when exp is 0) into a Cassandra TTL in seconds and the absolute
+// expiry used for the backup expiry check in Get.
+func (s *Storage) ttlAndExpiry(exp time.Duration) (int, *time.Time) {
 	var expiresAt *time.Time
 	var ttl int
 
@@ -189,26 +249,57 @@ func (s *Storage) Set(key string, value []byte, exp time.Duration) error {
 	}
 	// If exp == 0 and s.ttl == 0, no TTL will be set (live forever)
 
-	// Insert with TTL if specified
-	var query string
+	return ttl, expiresAt
+}
+
+// setQuery builds the INSERT statement and bind args used by Set, honoring
+// the default TTL from Config when exp is 0. CQL requires IF NOT EXISTS
+// (when ifNotExists is set) to precede USING TTL, so that ordering is
+// fixed here rather than left to callers.
+func (s *Storage) setQuery(key string, value []byte, exp time.Duration, ifNotExists bool) (string, []interface{}) {
+	ttl, expiresAt := s.ttlAndExpiry(exp)
+
+	query := fmt.Sprintf("INSERT INTO %s.%s (key, value, expires_at) VALUES (?, ?, ?)", s.keyspace, s.table)
+	if ifNotExists {
+		query += " IF NOT EXISTS"
+	}
 	if ttl > 0 {
-		query = fmt.Sprintf("INSERT INTO %s.%s (key, value, expires_at) VALUES (?, ?, ?) USING TTL %d",
-			s.keyspace, s.table, ttl)
-	} else {
-		query = fmt.Sprintf("INSERT INTO %s.%s (key, value, expires_at) VALUES (?, ?, ?)",
-			s.keyspace, s.table)
+		query += fmt.Sprintf(" USING TTL %d", ttl)
+	}
+
+	return query, []interface{}{key, value, expiresAt}
+}
+
+// SetIfNotExists stores a key-value pair only if the key doesn't already
+// exist, using a Cassandra lightweight transaction (INSERT ... IF NOT
+// EXISTS). It reports whether the insert was applied.
+func (s *Storage) SetIfNotExists(key string, value []byte, exp time.Duration, opts ...Option) (bool, error) {
+	query, args := s.setQuery(key, value, exp, true)
+
+	// A rejected insert returns the existing row's columns; MapScanCAS reads
+	// them by name, so their order and any extra columns (such as a WithUDT
+	// payload) don't matter.
+	applied, err := applyOptions(s.session.Query(query, args...), opts).MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return false, err
 	}
 
-	return s.session.Query(query, key, value, expiresAt).Exec()
+	return applied, nil
 }
 
 // Get retrieves a value by key
 func (s *Storage) Get(key string) ([]byte, error) {
+	return s.GetWithOptions(key)
+}
+
+// GetWithOptions retrieves a value by key, applying per-call options such as
+// WithConsistency.
+func (s *Storage) GetWithOptions(key string, opts ...Option) ([]byte, error) {
 	var value []byte
 	var expiresAt time.Time
 
 	query := fmt.Sprintf("SELECT value, expires_at FROM %s.%s WHERE key = ?", s.keyspace, s.table)
-	if err := s.session.Query(query, key).Scan(&value, &expiresAt); err != nil {
+	if err := applyOptions(s.session.Query(query, key), opts).Scan(&value, &expiresAt); err != nil {
 		if errors.Is(err, gocql.ErrNotFound) {
 			return nil, nil
 		}
@@ -230,8 +321,14 @@ func (s *Storage) Get(key string) ([]byte, error) {
 
 // Delete removes a key from storage
 func (s *Storage) Delete(key string) error {
+	return s.DeleteWithOptions(key)
+}
+
+// DeleteWithOptions removes a key from storage, applying per-call options
+// such as WithConsistency or WithIdempotent.
+func (s *Storage) DeleteWithOptions(key string, opts ...Option) error {
 	query := fmt.Sprintf("DELETE FROM %s.%s WHERE key = ?", s.keyspace, s.table)
-	return s.session.Query(query, key).Exec()
+	return applyOptions(s.session.Query(query, key), opts).Exec()
 }
 
 // Reset clears all keys from storage
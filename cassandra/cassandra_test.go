@@ -0,0 +1,86 @@
+package cassandra
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testStore *Storage
+var testConfig = ConfigDefault
+
+func TestMain(m *testing.M) {
+	testConfig.Reset = true
+
+	var err error
+	testStore, err = New(testConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	testStore.Close()
+	os.Exit(code)
+}
+
+func Test_Cassandra_SetAndGet(t *testing.T) {
+	err := testStore.Set("create", []byte("test12345"), 0)
+	require.NoError(t, err)
+
+	val, err := testStore.Get("create")
+	require.NoError(t, err)
+	require.Equal(t, []byte("test12345"), val)
+}
+
+func Test_Cassandra_GetMissing(t *testing.T) {
+	val, err := testStore.Get("non-existent-key")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func Test_Cassandra_Delete(t *testing.T) {
+	err := testStore.Set("delete", []byte("delete1234"), 0)
+	require.NoError(t, err)
+
+	err = testStore.Delete("delete")
+	require.NoError(t, err)
+
+	val, err := testStore.Get("delete")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func Test_Cassandra_GetExpired(t *testing.T) {
+	err := testStore.Set("temp", []byte("value"), 1*time.Second)
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Second)
+
+	val, err := testStore.Get("temp")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func Test_Cassandra_Reset(t *testing.T) {
+	err := testStore.Set("reset", []byte("value"), 0)
+	require.NoError(t, err)
+
+	err = testStore.Reset()
+	require.NoError(t, err)
+
+	val, err := testStore.Get("reset")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func BenchmarkSet(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		key := fmt.Sprintf("bench-key-%d", i)
+		if err := testStore.Set(key, []byte("value"), 0); err != nil {
+			b.Errorf("Set failed: %v", err)
+		}
+	}
+}
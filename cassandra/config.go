@@ -0,0 +1,100 @@
+package cassandra
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Config defines the config for storage.
+type Config struct {
+	// Hosts is the list of Cassandra node addresses to connect to.
+	Hosts []string
+
+	// Keyspace is the keyspace the storage table lives in.
+	// It is created on startup if it does not already exist.
+	Keyspace string
+
+	// Table is the name of the table used for storing key-value pairs.
+	Table string
+
+	// Consistency is the default consistency level for queries; a call can
+	// override it with WithConsistency.
+	Consistency gocql.Consistency
+
+	// Expiration is the default TTL applied to keys that don't specify one.
+	// A value <= 0 means keys are stored indefinitely by default.
+	Expiration time.Duration
+
+	// Reset clears any existing data when set to true.
+	Reset bool
+
+	// ReplicationStrategy is the keyspace replication strategy used when the
+	// keyspace doesn't already exist, either "SimpleStrategy" or
+	// "NetworkTopologyStrategy". Default: "SimpleStrategy".
+	ReplicationStrategy string
+
+	// ReplicationFactor is the replication factor used for "SimpleStrategy".
+	// Ignored when ReplicationStrategy is "NetworkTopologyStrategy". Default: 1.
+	ReplicationFactor int
+
+	// DataCenters maps data center name to replication factor and is required
+	// when ReplicationStrategy is "NetworkTopologyStrategy".
+	DataCenters map[string]int
+
+	// DurableWrites controls the keyspace's DURABLE_WRITES option. Left unset,
+	// Cassandra's own default (true) applies.
+	DurableWrites *bool
+
+	// BatchSize is the number of statements per batch used by SetMulti and
+	// DeleteMulti. Keep this low enough to stay under the cluster's
+	// batch_size_warn_threshold. Default: 30.
+	BatchSize int
+}
+
+// ConfigDefault is the default config
+var ConfigDefault = Config{
+	Hosts:               []string{"127.0.0.1"},
+	Keyspace:            "fiber_storage",
+	Table:               "fiber_storage",
+	Consistency:         gocql.Quorum,
+	ReplicationStrategy: "SimpleStrategy",
+	ReplicationFactor:   1,
+	BatchSize:           30,
+}
+
+// Helper function to set default values
+func configDefault(config ...Config) Config {
+	// Return default config if nothing provided
+	if len(config) < 1 {
+		return ConfigDefault
+	}
+
+	// Override default config
+	cfg := config[0]
+
+	// Set default values
+	if len(cfg.Hosts) == 0 {
+		cfg.Hosts = ConfigDefault.Hosts
+	}
+	if cfg.Keyspace == "" {
+		cfg.Keyspace = ConfigDefault.Keyspace
+	}
+	if cfg.Table == "" {
+		cfg.Table = ConfigDefault.Table
+	}
+	if cfg.Consistency == 0 {
+		cfg.Consistency = ConfigDefault.Consistency
+	}
+	if cfg.ReplicationStrategy == "" {
+		cfg.ReplicationStrategy = ConfigDefault.ReplicationStrategy
+	}
+	if cfg.ReplicationFactor == 0 {
+		cfg.ReplicationFactor = ConfigDefault.ReplicationFactor
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = ConfigDefault.BatchSize
+	}
+
+	return cfg
+}
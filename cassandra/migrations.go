@@ -0,0 +1,119 @@
+package cassandra
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+
+	"github.com/aliyasirnac/storage/internal/schema"
+)
+
+// UpdateFn applies a single incremental schema change to a cassandra
+// Storage's keyspace.
+type UpdateFn = schema.UpdateFunc
+
+// builtinUpdate is the update number reserved for updateCreateDataTable;
+// RegisterUpdate refuses to let callers overwrite it.
+const builtinUpdate = 1
+
+// RegisterUpdate returns a NewOption that registers fn as schema update
+// number n for the Storage being constructed by New. Use this to add
+// your own columns or tables (e.g. a `tags map<text,text>` column,
+// secondary index tables) without forking this package.
+//
+// Updates registered this way are scoped to the single Storage they're
+// passed into — unlike a process-wide registry, two New calls for two
+// different keyspaces in the same binary never see each other's updates.
+// Update numbers must be greater than the reserved built-in update #1;
+// RegisterUpdate panics otherwise, the same way database/sql.Register
+// panics on a bad driver registration. Passing two RegisterUpdate options
+// with the same number to one New call keeps the later function.
+func RegisterUpdate(n int, fn UpdateFn) NewOption {
+	if n <= builtinUpdate {
+		panic(fmt.Sprintf("cassandra: update number %d is reserved for the built-in data table update; register your updates starting at %d", n, builtinUpdate+1))
+	}
+
+	return func(s *Storage) {
+		if s.extraUpdates == nil {
+			s.extraUpdates = make(map[int]UpdateFn)
+		}
+		s.extraUpdates[n] = fn
+	}
+}
+
+// runMigrations brings the keyspace up to the latest schema update
+// registered on s (the built-in data table update plus any passed to New
+// via RegisterUpdate), recording progress in a schema_info table instead
+// of the previous all-or-nothing create/drop behavior.
+func (s *Storage) runMigrations() error {
+	migrator := schema.NewMigrator()
+	migrator.RegisterUpdate(builtinUpdate, s.updateCreateDataTable)
+	for n, fn := range s.extraUpdates {
+		migrator.RegisterUpdate(n, fn)
+	}
+
+	return migrator.Run(&cassandraVersionStore{s: s})
+}
+
+// updateCreateDataTable is schema update #1: create the data table. This
+// is the table creation that New used to run unconditionally.
+func (s *Storage) updateCreateDataTable(session schema.Session) error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s.%s (
+			key text PRIMARY KEY,
+			value blob,
+			expires_at timestamp
+		)
+	`, s.keyspace, s.table)
+
+	return session.Exec(query)
+}
+
+// cassandraVersionStore adapts a Storage's session to schema.VersionStore,
+// persisting the applied schema version in a single-row schema_info
+// table.
+type cassandraVersionStore struct {
+	s *Storage
+}
+
+// Exec runs query against the keyspace this Storage is connected to.
+func (v *cassandraVersionStore) Exec(query string, args ...interface{}) error {
+	return v.s.session.Query(query, args...).Exec()
+}
+
+// EnsureVersionTable creates the schema_info table if it doesn't exist.
+func (v *cassandraVersionStore) EnsureVersionTable() error {
+	query := fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s.schema_info (
+			id int PRIMARY KEY,
+			version int,
+			updated_at timestamp
+		)
+	`, v.s.keyspace)
+
+	return v.s.session.Query(query).Exec()
+}
+
+// CurrentVersion returns the version recorded in schema_info, or 0 if the
+// keyspace has never been migrated.
+func (v *cassandraVersionStore) CurrentVersion() (int, error) {
+	var version int
+
+	query := fmt.Sprintf("SELECT version FROM %s.schema_info WHERE id = 0", v.s.keyspace)
+	if err := v.s.session.Query(query).Scan(&version); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// RecordVersion persists version as the keyspace's current schema version.
+func (v *cassandraVersionStore) RecordVersion(version int, appliedAt time.Time) error {
+	query := fmt.Sprintf("INSERT INTO %s.schema_info (id, version, updated_at) VALUES (0, ?, ?)", v.s.keyspace)
+	return v.s.session.Query(query, version, appliedAt).Exec()
+}
@@ -0,0 +1,34 @@
+package cassandra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/aliyasirnac/storage/internal/schema"
+)
+
+func Test_Cassandra_SchemaVersionRecorded(t *testing.T) {
+	store := &cassandraVersionStore{s: testStore}
+
+	version, err := store.CurrentVersion()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, version, builtinUpdate)
+}
+
+func Test_Cassandra_RegisterUpdate_RejectsBuiltinVersion(t *testing.T) {
+	require.Panics(t, func() {
+		RegisterUpdate(builtinUpdate, func(_ schema.Session) error { return nil })
+	})
+}
+
+func Test_Cassandra_RegisterUpdate_ScopedPerStorage(t *testing.T) {
+	opt := RegisterUpdate(builtinUpdate+1, func(_ schema.Session) error { return nil })
+
+	a := &Storage{}
+	b := &Storage{}
+	opt(a)
+
+	require.Len(t, a.extraUpdates, 1)
+	require.Empty(t, b.extraUpdates, "RegisterUpdate must not leak into other Storage instances")
+}
@@ -0,0 +1,109 @@
+package cassandra
+
+import "github.com/gocql/gocql"
+
+// queryOptions holds the per-call settings applied by Option values.
+type queryOptions struct {
+	consistency       gocql.Consistency
+	consistencySet    bool
+	serialConsistency gocql.SerialConsistency
+	idempotent        bool
+	batchType         gocql.BatchType
+	batchTypeSet      bool
+}
+
+// Option configures a single Set/Get/Delete call, e.g. to request a
+// stronger consistency level or mark the query safe to retry.
+type Option func(*queryOptions)
+
+// WithConsistency overrides the consistency level used for this call.
+func WithConsistency(c gocql.Consistency) Option {
+	return func(o *queryOptions) {
+		o.consistency = c
+		o.consistencySet = true
+	}
+}
+
+// WithSerialConsistency sets the serial consistency used for lightweight
+// transactions (e.g. SetIfNotExists) issued by this call.
+func WithSerialConsistency(c gocql.SerialConsistency) Option {
+	return func(o *queryOptions) {
+		o.serialConsistency = c
+	}
+}
+
+// WithIdempotent marks the query as idempotent, allowing the driver to
+// retry it safely on timeout.
+func WithIdempotent(idempotent bool) Option {
+	return func(o *queryOptions) {
+		o.idempotent = idempotent
+	}
+}
+
+// WithBatchType selects the batch type used by SetMulti/DeleteMulti.
+// Use gocql.UnloggedBatch for higher throughput when every key in the
+// batch shares the same partition key; the default is gocql.LoggedBatch.
+func WithBatchType(t gocql.BatchType) Option {
+	return func(o *queryOptions) {
+		o.batchType = t
+		o.batchTypeSet = true
+	}
+}
+
+// resolveOptions evaluates opts into a queryOptions value callers can
+// inspect directly, for settings (like idempotency on a batch entry)
+// that can't be applied through a single *gocql.Query/*gocql.Batch call.
+func resolveOptions(opts []Option) queryOptions {
+	var o queryOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// applyOptions evaluates opts and applies them to q.
+func applyOptions(q *gocql.Query, opts []Option) *gocql.Query {
+	o := resolveOptions(opts)
+
+	if o.consistencySet {
+		q = q.Consistency(o.consistency)
+	}
+	if o.serialConsistency != 0 {
+		q = q.SerialConsistency(o.serialConsistency)
+	}
+	if o.idempotent {
+		q = q.Idempotent(true)
+	}
+
+	return q
+}
+
+// applyBatchOptions evaluates opts and applies the batch-level ones (not
+// idempotency, which gocql tracks per BatchEntry rather than on the
+// Batch itself — see addBatchEntry).
+func applyBatchOptions(b *gocql.Batch, opts []Option) *gocql.Batch {
+	o := resolveOptions(opts)
+
+	if o.consistencySet {
+		b.Cons = o.consistency
+	}
+	if o.serialConsistency != 0 {
+		b.SerialConsistency(o.serialConsistency)
+	}
+	if o.batchTypeSet {
+		b.Type = o.batchType
+	}
+
+	return b
+}
+
+// addBatchEntry appends query/args to b as a BatchEntry, carrying over
+// the Idempotent flag from opts (WithIdempotent) onto that entry.
+func addBatchEntry(b *gocql.Batch, opts []Option, query string, args ...interface{}) {
+	o := resolveOptions(opts)
+	b.Entries = append(b.Entries, gocql.BatchEntry{
+		Stmt:       query,
+		Args:       args,
+		Idempotent: o.idempotent,
+	})
+}
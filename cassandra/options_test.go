@@ -0,0 +1,42 @@
+package cassandra
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Cassandra_SetWithOptions(t *testing.T) {
+	err := testStore.SetWithOptions("with-options", []byte("value"), 0, WithConsistency(gocql.One), WithIdempotent(true))
+	require.NoError(t, err)
+
+	val, err := testStore.GetWithOptions("with-options", WithConsistency(gocql.One))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), val)
+}
+
+func Test_Cassandra_SetIfNotExists(t *testing.T) {
+	require.NoError(t, testStore.Delete("lwt-key"))
+
+	applied, err := testStore.SetIfNotExists("lwt-key", []byte("first"), 0)
+	require.NoError(t, err)
+	require.True(t, applied)
+
+	applied, err = testStore.SetIfNotExists("lwt-key", []byte("second"), 0)
+	require.NoError(t, err)
+	require.False(t, applied)
+
+	val, err := testStore.Get("lwt-key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), val)
+}
+
+func Test_Cassandra_SetIfNotExists_WithTTL(t *testing.T) {
+	require.NoError(t, testStore.Delete("lwt-ttl-key"))
+
+	applied, err := testStore.SetIfNotExists("lwt-ttl-key", []byte("value"), 1*time.Second)
+	require.NoError(t, err)
+	require.True(t, applied)
+}
@@ -0,0 +1,50 @@
+package cassandra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Cassandra_ReplicationClause_SimpleStrategy(t *testing.T) {
+	s := &Storage{replicationStrategy: "SimpleStrategy", replicationFactor: 3}
+
+	clause, err := s.replicationClause()
+	require.NoError(t, err)
+	require.Equal(t, "{'class': 'SimpleStrategy', 'replication_factor': 3}", clause)
+}
+
+func Test_Cassandra_ReplicationClause_SimpleStrategy_RejectsZeroFactor(t *testing.T) {
+	s := &Storage{replicationStrategy: "SimpleStrategy"}
+
+	_, err := s.replicationClause()
+	require.Error(t, err)
+}
+
+func Test_Cassandra_ReplicationClause_NetworkTopologyStrategy(t *testing.T) {
+	s := &Storage{
+		replicationStrategy: "NetworkTopologyStrategy",
+		dataCenters:         map[string]int{"dc1": 3, "dc2": 2},
+	}
+
+	clause, err := s.replicationClause()
+	require.NoError(t, err)
+	require.Equal(t, "{'class': 'NetworkTopologyStrategy', 'dc1': 3, 'dc2': 2}", clause)
+}
+
+func Test_Cassandra_ReplicationClause_NetworkTopologyStrategy_RequiresDataCenters(t *testing.T) {
+	s := &Storage{replicationStrategy: "NetworkTopologyStrategy"}
+
+	_, err := s.replicationClause()
+	require.Error(t, err)
+}
+
+func Test_Cassandra_ReplicationClause_NetworkTopologyStrategy_RejectsInvalidDCName(t *testing.T) {
+	s := &Storage{
+		replicationStrategy: "NetworkTopologyStrategy",
+		dataCenters:         map[string]int{"dc1'}; DROP KEYSPACE foo; --": 3},
+	}
+
+	_, err := s.replicationClause()
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package cassandra
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// UDTField is a single field of a UDTSpec, e.g. {"street", "text"}.
+type UDTField struct {
+	Name string
+	Type string
+}
+
+// UDTSpec describes a Cassandra user-defined type as an ordered list of
+// fields and their CQL types.
+type UDTSpec struct {
+	Fields []UDTField
+}
+
+// udtOption holds the name and spec passed to WithUDT.
+type udtOption struct {
+	name string
+	spec UDTSpec
+}
+
+// NewOption configures optional Storage behavior that doesn't belong in
+// Config, such as WithUDT.
+type NewOption func(*Storage)
+
+// WithUDT enables an optional typed-value mode for this Storage: ensuring
+// the named user-defined type exists, adding a `payload frozen<name>`
+// column to the data table, and enabling SetStruct/GetStruct. The
+// existing byte-oriented Set/Get API is unaffected.
+func WithUDT(name string, spec UDTSpec) NewOption {
+	return func(s *Storage) {
+		s.udt = &udtOption{name: name, spec: spec}
+	}
+}
+
+// ensureUDT creates the configured UDT and payload column if they don't
+// already exist.
+func (s *Storage) ensureUDT() error {
+	if err := s.CreateRequiredTypes(map[string]UDTSpec{s.udt.name: s.udt.spec}); err != nil {
+		return err
+	}
+
+	return s.addPayloadColumn()
+}
+
+// CreateRequiredTypes queries system_schema.types for the UDTs that
+// already exist in the keyspace, diffs that against types, and issues
+// CREATE TYPE for whichever are missing.
+func (s *Storage) CreateRequiredTypes(types map[string]UDTSpec) error {
+	iter := s.session.Query(
+		"SELECT type_name FROM system_schema.types WHERE keyspace_name = ?", s.keyspace,
+	).Iter()
+
+	existing := make(map[string]bool)
+	var typeName string
+	for iter.Scan(&typeName) {
+		existing[typeName] = true
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for name, spec := range types {
+		if existing[name] {
+			continue
+		}
+		if err := s.createType(name, spec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createType issues CREATE TYPE for name using spec's fields.
+func (s *Storage) createType(name string, spec UDTSpec) error {
+	fields := make([]string, len(spec.Fields))
+	for i, f := range spec.Fields {
+		fields[i] = fmt.Sprintf("%s %s", f.Name, f.Type)
+	}
+
+	query := fmt.Sprintf("CREATE TYPE IF NOT EXISTS %s.%s (%s)", s.keyspace, name, strings.Join(fields, ", "))
+	return s.session.Query(query).Exec()
+}
+
+// addPayloadColumn alters the data table to carry a payload column of the
+// configured UDT, tolerating the column already existing from a prior run.
+func (s *Storage) addPayloadColumn() error {
+	query := fmt.Sprintf("ALTER TABLE %s.%s ADD payload frozen<%s>", s.keyspace, s.table, s.udt.name)
+	if err := s.session.Query(query).Exec(); err != nil {
+		if strings.Contains(err.Error(), "Invalid column name") || strings.Contains(err.Error(), "already exist") {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SetStruct stores v in the payload UDT column using gocql's UDT
+// marshalling instead of the opaque value blob. WithUDT must have been
+// passed to New.
+func (s *Storage) SetStruct(key string, v any, exp time.Duration) error {
+	if s.udt == nil {
+		return errors.New("cassandra: SetStruct requires WithUDT to be configured")
+	}
+
+	ttl, expiresAt := s.ttlAndExpiry(exp)
+
+	var query string
+	if ttl > 0 {
+		query = fmt.Sprintf("INSERT INTO %s.%s (key, expires_at, payload) VALUES (?, ?, ?) USING TTL %d",
+			s.keyspace, s.table, ttl)
+	} else {
+		query = fmt.Sprintf("INSERT INTO %s.%s (key, expires_at, payload) VALUES (?, ?, ?)",
+			s.keyspace, s.table)
+	}
+
+	return s.session.Query(query, key, expiresAt, v).Exec()
+}
+
+// GetStruct reads the payload UDT column for key into dst using gocql's
+// UDT marshalling. WithUDT must have been passed to New. A missing or
+// expired key leaves dst untouched and returns a nil error.
+func (s *Storage) GetStruct(key string, dst any) error {
+	if s.udt == nil {
+		return errors.New("cassandra: GetStruct requires WithUDT to be configured")
+	}
+
+	dstVal := reflect.ValueOf(dst)
+	if dstVal.Kind() != reflect.Ptr || dstVal.IsNil() {
+		return errors.New("cassandra: GetStruct requires a non-nil pointer destination")
+	}
+
+	// Scan into a throwaway value of dst's type first, so that an
+	// expired row (deleted below) never ends up overwriting dst.
+	tmp := reflect.New(dstVal.Elem().Type())
+
+	var expiresAt time.Time
+	query := fmt.Sprintf("SELECT payload, expires_at FROM %s.%s WHERE key = ?", s.keyspace, s.table)
+	if err := s.session.Query(query, key).Scan(tmp.Interface(), &expiresAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// Check if expired (as a backup in case TTL didn't work)
+	if !expiresAt.IsZero() && expiresAt.Before(time.Now()) {
+		// Expired but not yet removed by TTL
+		if err := s.Delete(key); err != nil {
+			log.Printf("Failed to delete expired key %s: %v", key, err)
+		}
+		return nil
+	}
+
+	dstVal.Elem().Set(tmp.Elem())
+	return nil
+}
@@ -0,0 +1,73 @@
+package cassandra
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAddress struct {
+	Street string
+	Zip    int
+}
+
+// newUDTStore returns a Storage with the "address" UDT configured,
+// closing it when the test completes.
+func newUDTStore(t *testing.T) *Storage {
+	t.Helper()
+
+	cfg := testConfig
+	cfg.Keyspace = "fiber_storage_udt_test"
+	cfg.Table = "fiber_storage_udt_test"
+
+	spec := UDTSpec{Fields: []UDTField{
+		{Name: "street", Type: "text"},
+		{Name: "zip", Type: "int"},
+	}}
+
+	store, err := New(cfg, WithUDT("address", spec))
+	require.NoError(t, err)
+	t.Cleanup(store.Close)
+
+	return store
+}
+
+func Test_Cassandra_SetStructGetStruct(t *testing.T) {
+	store := newUDTStore(t)
+
+	in := testAddress{Street: "Main St", Zip: 12345}
+	err := store.SetStruct("home", in, 0)
+	require.NoError(t, err)
+
+	var out testAddress
+	err = store.GetStruct("home", &out)
+	require.NoError(t, err)
+	require.Equal(t, in, out)
+}
+
+func Test_Cassandra_GetStruct_Expired(t *testing.T) {
+	store := newUDTStore(t)
+
+	in := testAddress{Street: "Old St", Zip: 54321}
+	err := store.SetStruct("expiring", in, 1*time.Second)
+	require.NoError(t, err)
+
+	time.Sleep(2 * time.Second)
+
+	out := testAddress{Street: "untouched", Zip: -1}
+	err = store.GetStruct("expiring", &out)
+	require.NoError(t, err)
+	require.Equal(t, testAddress{Street: "untouched", Zip: -1}, out, "dst must be left untouched for an expired key")
+}
+
+func Test_Cassandra_SetStruct_RequiresUDT(t *testing.T) {
+	err := testStore.SetStruct("no-udt", testAddress{}, 0)
+	require.Error(t, err)
+}
+
+func Test_Cassandra_GetStruct_RequiresUDT(t *testing.T) {
+	var out testAddress
+	err := testStore.GetStruct("no-udt", &out)
+	require.Error(t, err)
+}
@@ -0,0 +1,105 @@
+// Package schema implements a shared incremental-update migration runner
+// for this repo's SQL/CQL-backed storage adapters, modeled on the
+// update-registry pattern used by projects like LXD: each backend
+// registers an ordered list of Update functions plus a place to persist
+// the currently applied version, and Migrator.Run brings the backend's
+// schema up to date one update at a time.
+package schema
+
+import (
+	"fmt"
+	"time"
+)
+
+// UpdateFunc applies a single incremental schema change against a
+// backend's Session.
+type UpdateFunc func(s Session) error
+
+// Session is the minimal query executor a backend must provide to run
+// migrations.
+type Session interface {
+	Exec(query string, args ...interface{}) error
+}
+
+// VersionStore is a Session that can also read and persist the backend's
+// currently applied schema version.
+type VersionStore interface {
+	Session
+
+	// EnsureVersionTable creates the backend's schema_info table if it
+	// doesn't already exist.
+	EnsureVersionTable() error
+
+	// CurrentVersion returns the highest applied update number, or 0 if
+	// no updates have been applied yet.
+	CurrentVersion() (int, error)
+
+	// RecordVersion persists that update number version was applied at
+	// appliedAt.
+	RecordVersion(version int, appliedAt time.Time) error
+}
+
+// Migrator runs a set of registered Update functions against a
+// VersionStore, in order, starting from the version already recorded
+// there.
+type Migrator struct {
+	updates map[int]UpdateFunc
+}
+
+// NewMigrator returns an empty Migrator.
+func NewMigrator() *Migrator {
+	return &Migrator{updates: make(map[int]UpdateFunc)}
+}
+
+// RegisterUpdate registers fn as update number n. Registering the same
+// number twice overwrites the earlier registration.
+func (m *Migrator) RegisterUpdate(n int, fn UpdateFunc) {
+	m.updates[n] = fn
+}
+
+// Latest returns the highest registered update number, or 0 if none are
+// registered.
+func (m *Migrator) Latest() int {
+	latest := 0
+	for n := range m.updates {
+		if n > latest {
+			latest = n
+		}
+	}
+	return latest
+}
+
+// Run ensures store's version table exists, then applies any update
+// numbers greater than store's current version, in order, recording the
+// new version after each one. It refuses to run if store's current
+// version is newer than the highest update this Migrator knows about.
+func (m *Migrator) Run(store VersionStore) error {
+	if err := store.EnsureVersionTable(); err != nil {
+		return fmt.Errorf("schema: failed to ensure version table: %w", err)
+	}
+
+	current, err := store.CurrentVersion()
+	if err != nil {
+		return fmt.Errorf("schema: failed to read current schema version: %w", err)
+	}
+
+	latest := m.Latest()
+	if current > latest {
+		return fmt.Errorf("schema: database is at version %d but this build only knows updates up to %d", current, latest)
+	}
+
+	for n := current + 1; n <= latest; n++ {
+		fn, ok := m.updates[n]
+		if !ok {
+			return fmt.Errorf("schema: missing update #%d", n)
+		}
+		if err := fn(store); err != nil {
+			return fmt.Errorf("schema: update #%d failed: %w", n, err)
+		}
+		if err := store.RecordVersion(n, time.Now()); err != nil {
+			return fmt.Errorf("schema: failed to record schema version %d: %w", n, err)
+		}
+	}
+
+	return nil
+}
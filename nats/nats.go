@@ -0,0 +1,128 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Storage represents a NATS JetStream key-value storage implementation
+type Storage struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	kv     jetstream.KeyValue
+	cfg    Config
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a new NATS storage instance
+func New(config ...Config) (*Storage, error) {
+	cfg := configDefault(config...)
+
+	opts := append([]nats.Option{}, cfg.NatsOptions...)
+	opts = append(opts, nats.Name(cfg.ClientName))
+
+	conn, err := nats.Connect(cfg.URLs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("nats storage: failed to connect: %w", err)
+	}
+
+	if !conn.IsConnected() {
+		time.Sleep(cfg.WaitForConnection)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("nats storage: failed to create jetstream context: %w", err)
+	}
+
+	kv, err := js.CreateOrUpdateKeyValue(cfg.Context, cfg.KeyValueConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("nats storage: failed to create key value store: %w", err)
+	}
+
+	return &Storage{
+		conn:   conn,
+		js:     js,
+		kv:     kv,
+		cfg:    cfg,
+		closed: make(chan struct{}),
+	}, nil
+}
+
+// Get retrieves a value by key
+func (s *Storage) Get(key string) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+
+	entry, err := s.kv.Get(s.cfg.Context, key)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return entry.Value(), nil
+}
+
+// Set stores a key-value pair. exp is currently ignored; configure a TTL
+// on the bucket itself via Config.KeyValueConfig.TTL instead.
+func (s *Storage) Set(key string, val []byte, _ time.Duration) error {
+	if len(key) == 0 {
+		return nil
+	}
+
+	_, err := s.kv.Put(s.cfg.Context, key, val)
+	return err
+}
+
+// Delete removes a key from storage
+func (s *Storage) Delete(key string) error {
+	if len(key) == 0 {
+		return nil
+	}
+
+	return s.kv.Delete(s.cfg.Context, key)
+}
+
+// Reset purges all keys from the bucket
+func (s *Storage) Reset() error {
+	keys, err := s.kv.Keys(s.cfg.Context)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrNoKeysFound) {
+			return nil
+		}
+		return err
+	}
+
+	for _, key := range keys {
+		if err := s.kv.Purge(s.cfg.Context, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close stops any in-flight Watch subscriptions and closes the NATS
+// connection.
+func (s *Storage) Close() error {
+	close(s.closed)
+	s.wg.Wait()
+	s.conn.Close()
+	return nil
+}
+
+// Conn returns the underlying NATS connection.
+func (s *Storage) Conn() *nats.Conn {
+	return s.conn
+}
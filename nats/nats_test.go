@@ -0,0 +1,86 @@
+package nats
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testStore *Storage
+var testConfig = ConfigDefault
+
+func TestMain(m *testing.M) {
+	var err error
+	testStore, err = New(testConfig)
+	if err != nil {
+		panic(err)
+	}
+
+	code := m.Run()
+	if err := testStore.Close(); err != nil {
+		os.Stderr.WriteString("failed to close store: " + err.Error() + "\n")
+	}
+	os.Exit(code)
+}
+
+func Test_Nats_SetAndGet(t *testing.T) {
+	err := testStore.Set("create", []byte("test12345"), 0)
+	require.NoError(t, err)
+
+	val, err := testStore.Get("create")
+	require.NoError(t, err)
+	require.Equal(t, []byte("test12345"), val)
+}
+
+func Test_Nats_GetMissing(t *testing.T) {
+	val, err := testStore.Get("non-existent-key")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func Test_Nats_Delete(t *testing.T) {
+	err := testStore.Set("delete", []byte("value"), 0)
+	require.NoError(t, err)
+
+	err = testStore.Delete("delete")
+	require.NoError(t, err)
+
+	val, err := testStore.Get("delete")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func Test_Nats_Reset(t *testing.T) {
+	err := testStore.Set("reset", []byte("value"), 0)
+	require.NoError(t, err)
+
+	err = testStore.Reset()
+	require.NoError(t, err)
+
+	val, err := testStore.Get("reset")
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
+func Test_Nats_Watch(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	events, err := testStore.Watch(ctx, "watch-key")
+	require.NoError(t, err)
+
+	err = testStore.Set("watch-key", []byte("value"), 0)
+	require.NoError(t, err)
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok)
+		require.Equal(t, "watch-key", event.Key)
+		require.Equal(t, OperationPut, event.Operation)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for watch event")
+	}
+}
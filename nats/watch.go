@@ -0,0 +1,118 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Operation identifies the kind of change a KVEvent represents.
+type Operation int
+
+const (
+	// OperationPut means the key was created or updated.
+	OperationPut Operation = iota
+	// OperationDelete means the key was deleted.
+	OperationDelete
+	// OperationPurge means the key's history was purged.
+	OperationPurge
+)
+
+// KVEvent is a single change notification delivered by Watch.
+type KVEvent struct {
+	Key       string
+	Value     []byte
+	Revision  uint64
+	Operation Operation
+	CreatedAt time.Time
+}
+
+// WatchOption configures a Watch call.
+type WatchOption func() jetstream.WatchOpt
+
+// IncludeHistory makes Watch also emit the existing history for keys
+// matching the pattern before switching to live updates.
+func IncludeHistory() WatchOption {
+	return func() jetstream.WatchOpt { return jetstream.IncludeHistory() }
+}
+
+// MetaOnly makes Watch omit values, delivering only key/revision/operation
+// metadata for each event.
+func MetaOnly() WatchOption {
+	return func() jetstream.WatchOpt { return jetstream.MetaOnly() }
+}
+
+// Watch subscribes to changes for keys matching keyPattern and returns a
+// channel of KVEvent values. An empty keyPattern watches every key in the
+// bucket. The channel is closed when ctx is cancelled or the Storage is
+// Closed.
+func (s *Storage) Watch(ctx context.Context, keyPattern string, opts ...WatchOption) (<-chan KVEvent, error) {
+	watchOpts := make([]jetstream.WatchOpt, 0, len(opts))
+	for _, opt := range opts {
+		watchOpts = append(watchOpts, opt())
+	}
+
+	var watcher jetstream.KeyWatcher
+	var err error
+	if keyPattern == "" {
+		watcher, err = s.kv.WatchAll(ctx, watchOpts...)
+	} else {
+		watcher, err = s.kv.Watch(ctx, keyPattern, watchOpts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("nats storage: failed to watch %q: %w", keyPattern, err)
+	}
+
+	events := make(chan KVEvent)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(events)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-s.closed:
+				return
+			case <-ctx.Done():
+				return
+			case entry, ok := <-watcher.Updates():
+				if !ok {
+					return
+				}
+				// A nil entry marks the end of the initial history replay.
+				if entry == nil {
+					continue
+				}
+
+				event := KVEvent{
+					Key:       entry.Key(),
+					Value:     entry.Value(),
+					Revision:  entry.Revision(),
+					CreatedAt: entry.Created(),
+				}
+				switch entry.Operation() {
+				case jetstream.KeyValueDelete:
+					event.Operation = OperationDelete
+				case jetstream.KeyValuePurge:
+					event.Operation = OperationPurge
+				default:
+					event.Operation = OperationPut
+				}
+
+				select {
+				case events <- event:
+				case <-s.closed:
+					return
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}